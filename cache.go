@@ -17,6 +17,9 @@ type Cache interface {
 	Clear(context.Context, interface{})
 	// ClearAll empties the cache entirely.
 	ClearAll(context.Context)
+	// Keys returns the keys currently held in the cache. Implementations
+	// that cannot enumerate their keys cheaply may return nil.
+	Keys(context.Context) []interface{}
 }
 
 // NoCache is a Cache implementation whose methods are all no-ops, useful
@@ -35,6 +38,9 @@ func (c *NoCache) Clear(context.Context, interface{}) {}
 // ClearAll does nothing.
 func (c *NoCache) ClearAll(context.Context) {}
 
+// Keys always returns nil.
+func (c *NoCache) Keys(context.Context) []interface{} { return nil }
+
 // InMemoryCache is the default Cache implementation. It is backed by a
 // plain map and grows without bound, so long-running processes that see a
 // large or unbounded key space should prefer LRUCache instead.
@@ -76,3 +82,14 @@ func (c *InMemoryCache) ClearAll(context.Context) {
 	defer c.mu.Unlock()
 	c.items = make(map[interface{}]Thunk)
 }
+
+// Keys returns the keys currently held in the cache.
+func (c *InMemoryCache) Keys(context.Context) []interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := make([]interface{}, 0, len(c.items))
+	for key := range c.items {
+		keys = append(keys, key)
+	}
+	return keys
+}
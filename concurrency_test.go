@@ -0,0 +1,131 @@
+package dataloader
+
+import (
+	"context"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mixedWorkload drives a mix of Load (80%), Prime (10%) and Clear (10%)
+// calls against loader using keys drawn from [0, keySpace), using r for
+// all randomness so a run is reproducible given its seed.
+func mixedWorkload(loader *Loader, r *rand.Rand, keySpace, ops int) {
+	ctx := context.Background()
+	for i := 0; i < ops; i++ {
+		key := strconv.Itoa(r.Intn(keySpace))
+		switch roll := r.Float64(); {
+		case roll < 0.8:
+			future := loader.Load(ctx, key)
+			future()
+		case roll < 0.9:
+			loader.Prime(ctx, key, key)
+		default:
+			loader.Clear(ctx, key)
+		}
+	}
+}
+
+// TestLoaderConcurrentStress hammers a single Loader from many goroutines
+// with a mixed Load/Prime/Clear workload, the way a busy production loader
+// sees traffic. It exists to stress the batching mutex, the cache and the
+// panic-recovery path under real contention; run with -race to catch data
+// races that a single-goroutine benchmark never exercises.
+func TestLoaderConcurrentStress(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping stress test in short mode")
+	}
+
+	const (
+		keySpace = 50
+		totalOps = 20000
+	)
+
+	seed := time.Now().UnixNano()
+	t.Logf("seed=%d", seed)
+	seeder := rand.New(rand.NewSource(seed))
+
+	loader, _ := IDLoader(0)
+
+	workers := runtime.NumCPU()
+	opsPerWorker := totalOps / workers
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		workerSeed := seeder.Int63()
+		go func(seed int64) {
+			defer wg.Done()
+			mixedWorkload(loader, rand.New(rand.NewSource(seed)), keySpace, opsPerWorker)
+		}(workerSeed)
+	}
+	wg.Wait()
+}
+
+// TestThunkConcurrentRace calls the same Thunk from many goroutines with no
+// synchronization beyond the Thunk itself, proving a Load's Thunk can be
+// safely resolved concurrently by more than the two goroutines the
+// "does not contain race conditions" tests launch. Run with -race.
+func TestThunkConcurrentRace(t *testing.T) {
+	loader, _ := IDLoader(0)
+	ctx := context.Background()
+	future := loader.Load(ctx, "1")
+
+	const goroutines = 50
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			if _, err := future(); err != nil {
+				t.Error(err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// BenchmarkLoaderConcurrent exercises NumCPU goroutines against a shared
+// Loader with the same mixed Load/Prime/Clear workload as
+// TestLoaderConcurrentStress, and reports throughput alongside the average
+// batch size so regressions in batching behavior under contention show up
+// in benchmark output, not just in unit tests.
+func BenchmarkLoaderConcurrent(b *testing.B) {
+	const keySpace = 100
+
+	avg := &Avg{}
+	loader := NewBatchedLoader(func(_ context.Context, keys []interface{}) []*Result {
+		avg.Add(len(keys))
+		results := make([]*Result, len(keys))
+		for i, key := range keys {
+			results[i] = &Result{key, nil}
+		}
+		return results
+	})
+
+	workers := runtime.NumCPU()
+	opsPerWorker := b.N / workers
+	if opsPerWorker == 0 {
+		opsPerWorker = 1
+	}
+
+	b.ResetTimer()
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(seed int64) {
+			defer wg.Done()
+			mixedWorkload(loader, rand.New(rand.NewSource(seed)), keySpace, opsPerWorker)
+		}(int64(w) + 1)
+	}
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	b.ReportMetric(float64(workers*opsPerWorker)/elapsed.Seconds(), "ops/sec")
+	b.ReportMetric(avg.Avg(), "avg-batch-size")
+}
@@ -76,6 +76,10 @@ type Loader struct {
 
 	mu       sync.Mutex
 	curBatch *batch
+
+	subMu     sync.RWMutex
+	subs      map[int]chan<- CacheEvent
+	nextSubID int
 }
 
 // Option configures a Loader created by NewBatchedLoader.
@@ -239,12 +243,17 @@ func (l *Loader) LoadMany(ctx context.Context, keys []interface{}) ThunkMany {
 // cached. It returns the Loader so calls can be chained.
 func (l *Loader) Prime(ctx context.Context, key, value interface{}) *Loader {
 	l.mu.Lock()
-	defer l.mu.Unlock()
-	if _, ok := l.cache.Get(ctx, key); !ok {
+	_, ok := l.cache.Get(ctx, key)
+	if !ok {
 		l.cache.Set(ctx, key, func() (interface{}, error) {
 			return value, nil
 		})
 	}
+	l.mu.Unlock()
+
+	if !ok {
+		l.publish(CacheEvent{Op: CacheOpPrime, Key: key, Value: value})
+	}
 	return l
 }
 
@@ -252,6 +261,7 @@ func (l *Loader) Prime(ctx context.Context, key, value interface{}) *Loader {
 // calls can be chained, e.g. loader.Clear(ctx, key).Load(ctx, key).
 func (l *Loader) Clear(ctx context.Context, key interface{}) *Loader {
 	l.cache.Clear(ctx, key)
+	l.publish(CacheEvent{Op: CacheOpClear, Key: key})
 	return l
 }
 
@@ -259,9 +269,82 @@ func (l *Loader) Clear(ctx context.Context, key interface{}) *Loader {
 // chained.
 func (l *Loader) ClearAll() *Loader {
 	l.cache.ClearAll(context.Background())
+	l.publish(CacheEvent{Op: CacheOpClearAll})
 	return l
 }
 
+// CacheOp identifies the kind of cache mutation a CacheEvent reports.
+type CacheOp int
+
+// The cache mutations that generate CacheEvents.
+const (
+	CacheOpPrime CacheOp = iota
+	CacheOpClear
+	CacheOpClearAll
+)
+
+// String implements fmt.Stringer.
+func (op CacheOp) String() string {
+	switch op {
+	case CacheOpPrime:
+		return "prime"
+	case CacheOpClear:
+		return "clear"
+	case CacheOpClearAll:
+		return "clearAll"
+	default:
+		return "unknown"
+	}
+}
+
+// CacheEvent describes a cache mutation made through Prime, Clear or
+// ClearAll. Subscribers can bridge these events to a pub/sub system (Redis,
+// NATS, Kafka, ...) so peer processes can invalidate their own local
+// caches when a mutation happens elsewhere.
+type CacheEvent struct {
+	Op    CacheOp
+	Key   interface{}
+	Value interface{}
+}
+
+// Subscribe registers ch to receive CacheEvents for every Prime, Clear and
+// ClearAll call made on the Loader, and returns a function that removes
+// the subscription. Delivery is non-blocking: if ch is full, the event is
+// dropped and a warning is logged rather than stalling the caller.
+// Unsubscribe is safe to call more than once and from multiple goroutines.
+func (l *Loader) Subscribe(ch chan<- CacheEvent) (unsubscribe func()) {
+	l.subMu.Lock()
+	if l.subs == nil {
+		l.subs = make(map[int]chan<- CacheEvent)
+	}
+	id := l.nextSubID
+	l.nextSubID++
+	l.subs[id] = ch
+	l.subMu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.subMu.Lock()
+			delete(l.subs, id)
+			l.subMu.Unlock()
+		})
+	}
+}
+
+// publish fans evt out to every current subscriber without blocking.
+func (l *Loader) publish(evt CacheEvent) {
+	l.subMu.RLock()
+	defer l.subMu.RUnlock()
+	for _, ch := range l.subs {
+		select {
+		case ch <- evt:
+		default:
+			l.logf("dataloader: dropping cache event %v for a full subscriber channel", evt.Op)
+		}
+	}
+}
+
 // logf reports a diagnostic message unless the Loader has been silenced.
 func (l *Loader) logf(format string, args ...interface{}) {
 	if l.silent || l.logger == nil {
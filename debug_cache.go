@@ -0,0 +1,59 @@
+package dataloader
+
+import (
+	"context"
+	"log"
+)
+
+// DebugCache wraps another Cache and logs every Get, Set, Clear and
+// ClearAll call it sees, including whether a Get was a hit or a miss. It is
+// meant to be dropped in via WithCache while tracking down why a Loader is
+// or isn't batching as expected.
+type DebugCache struct {
+	inner  Cache
+	logger *log.Logger
+}
+
+// NewDebugCache creates a DebugCache that wraps inner and writes to logger.
+// A nil logger falls back to log.Default().
+func NewDebugCache(inner Cache, logger *log.Logger) *DebugCache {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &DebugCache{inner: inner, logger: logger}
+}
+
+// Get returns the Thunk cached for key, if any, logging whether it was a
+// hit or a miss.
+func (c *DebugCache) Get(ctx context.Context, key interface{}) (Thunk, bool) {
+	thunk, ok := c.inner.Get(ctx, key)
+	if ok {
+		c.logger.Printf("dataloader: cache hit for key %v", key)
+	} else {
+		c.logger.Printf("dataloader: cache miss for key %v", key)
+	}
+	return thunk, ok
+}
+
+// Set caches value under key, logging the call.
+func (c *DebugCache) Set(ctx context.Context, key interface{}, value Thunk) {
+	c.logger.Printf("dataloader: cache set for key %v", key)
+	c.inner.Set(ctx, key, value)
+}
+
+// Clear removes key from the cache, if present, logging the call.
+func (c *DebugCache) Clear(ctx context.Context, key interface{}) {
+	c.logger.Printf("dataloader: cache clear for key %v", key)
+	c.inner.Clear(ctx, key)
+}
+
+// ClearAll empties the cache entirely, logging the call.
+func (c *DebugCache) ClearAll(ctx context.Context) {
+	c.logger.Printf("dataloader: cache clearAll")
+	c.inner.ClearAll(ctx)
+}
+
+// Keys returns the keys currently held in the wrapped cache.
+func (c *DebugCache) Keys(ctx context.Context) []interface{} {
+	return c.inner.Keys(ctx)
+}
@@ -0,0 +1,68 @@
+package dataloader
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestDebugCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("logs hits and misses", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		cache := NewDebugCache(NewInMemoryCache(), log.New(&buf, "", 0))
+
+		cache.Get(ctx, "1") // miss
+		cache.Set(ctx, "1", func() (interface{}, error) { return "1", nil })
+		cache.Get(ctx, "1") // hit
+
+		out := buf.String()
+		if !strings.Contains(out, "cache miss for key 1") {
+			t.Errorf("expected log output to record a miss, got %q", out)
+		}
+		if !strings.Contains(out, "cache hit for key 1") {
+			t.Errorf("expected log output to record a hit, got %q", out)
+		}
+	})
+
+	t.Run("loader using a DebugCache logs cache activity for repeated Loads", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		cache := NewDebugCache(NewInMemoryCache(), log.New(&buf, "", 0))
+
+		var loadCalls [][]interface{}
+		loader := NewBatchedLoader(func(_ context.Context, keys []interface{}) []*Result {
+			loadCalls = append(loadCalls, keys)
+			results := make([]*Result, len(keys))
+			for i, key := range keys {
+				results[i] = &Result{key, nil}
+			}
+			return results
+		}, WithCache(cache))
+
+		future1 := loader.Load(ctx, "1")
+		future2 := loader.Load(ctx, "1")
+		future1()
+		future2()
+
+		if len(loadCalls) != 1 {
+			t.Errorf("expected a single batch call, got %d", len(loadCalls))
+		}
+		if !strings.Contains(buf.String(), "cache hit for key 1") {
+			t.Errorf("expected log output to record the cache hit on the second Load, got %q", buf.String())
+		}
+	})
+
+	t.Run("a nil logger falls back to a usable default instead of panicking", func(t *testing.T) {
+		t.Parallel()
+		cache := NewDebugCache(NewInMemoryCache(), nil)
+		cache.Get(ctx, "1")
+		cache.Set(ctx, "1", func() (interface{}, error) { return "1", nil })
+		cache.Clear(ctx, "1")
+		cache.ClearAll(ctx)
+	})
+}
@@ -0,0 +1,124 @@
+package dataloader
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// lruEntry is the value stored in an LRUCache's list.Element.
+type lruEntry struct {
+	key   interface{}
+	value Thunk
+}
+
+// LRUCache is a Cache implementation with a fixed capacity: once full, the
+// least recently used entry is evicted to make room for a new one. It uses
+// the standard map+list LRU pattern, so Get, Set and Clear are all O(1).
+//
+// LRUCache tracks hit, miss and eviction counts so callers can measure
+// cache effectiveness; see Hits, Misses and Evictions.
+type LRUCache struct {
+	capacity int
+
+	mu    sync.Mutex
+	items map[interface{}]*list.Element
+	order *list.List
+
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+// NewLRUCache creates an LRUCache that holds at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		items:    make(map[interface{}]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// Get returns the Thunk cached for key, if any, moving it to the front of
+// the recency list on a hit.
+func (c *LRUCache) Get(_ context.Context, key interface{}) (Thunk, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+
+	atomic.AddUint64(&c.hits, 1)
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, true
+}
+
+// Set caches value under key, inserting it at the front of the recency
+// list. If the cache is over capacity afterwards, the least recently used
+// entry is evicted.
+func (c *LRUCache) Set(_ context.Context, key interface{}, value Thunk) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruEntry).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	elem := c.order.PushFront(&lruEntry{key: key, value: value})
+	c.items[key] = elem
+
+	if c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).key)
+			atomic.AddUint64(&c.evictions, 1)
+		}
+	}
+}
+
+// Clear removes key from the cache, if present.
+func (c *LRUCache) Clear(_ context.Context, key interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// ClearAll empties the cache entirely.
+func (c *LRUCache) ClearAll(context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[interface{}]*list.Element, c.capacity)
+	c.order.Init()
+}
+
+// Keys returns the keys currently held in the cache.
+func (c *LRUCache) Keys(context.Context) []interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := make([]interface{}, 0, len(c.items))
+	for key := range c.items {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Hits returns the number of Get calls that found a cached value.
+func (c *LRUCache) Hits() uint64 { return atomic.LoadUint64(&c.hits) }
+
+// Misses returns the number of Get calls that found no cached value.
+func (c *LRUCache) Misses() uint64 { return atomic.LoadUint64(&c.misses) }
+
+// Evictions returns the number of entries evicted to stay within capacity.
+func (c *LRUCache) Evictions() uint64 { return atomic.LoadUint64(&c.evictions) }
@@ -0,0 +1,144 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Run("evicts least recently used entry when full", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		cache := NewLRUCache(2)
+
+		thunk := func(v interface{}) Thunk {
+			return func() (interface{}, error) { return v, nil }
+		}
+
+		cache.Set(ctx, "a", thunk("a"))
+		cache.Set(ctx, "b", thunk("b"))
+		cache.Set(ctx, "c", thunk("c")) // evicts "a"
+
+		if _, found := cache.Get(ctx, "a"); found {
+			t.Error("expected least recently used entry to be evicted")
+		}
+		if _, found := cache.Get(ctx, "b"); !found {
+			t.Error("expected \"b\" to still be cached")
+		}
+		if _, found := cache.Get(ctx, "c"); !found {
+			t.Error("expected \"c\" to still be cached")
+		}
+		if got := cache.Evictions(); got != 1 {
+			t.Errorf("expected 1 eviction, got %d", got)
+		}
+	})
+
+	t.Run("priming N+1 entries into a capacity-N cache evicts the oldest", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		const n = 5
+		cache := NewLRUCache(n)
+
+		thunk := func(v interface{}) Thunk {
+			return func() (interface{}, error) { return v, nil }
+		}
+
+		for i := 0; i < n+1; i++ {
+			cache.Set(ctx, i, thunk(i))
+		}
+
+		if _, found := cache.Get(ctx, 0); found {
+			t.Error("expected first-primed entry to have been evicted")
+		}
+		for i := 1; i < n+1; i++ {
+			if _, found := cache.Get(ctx, i); !found {
+				t.Errorf("expected entry %d to still be cached", i)
+			}
+		}
+	})
+
+	t.Run("Get moves a hit to the front, sparing it from eviction", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		cache := NewLRUCache(2)
+
+		thunk := func(v interface{}) Thunk {
+			return func() (interface{}, error) { return v, nil }
+		}
+
+		cache.Set(ctx, "a", thunk("a"))
+		cache.Set(ctx, "b", thunk("b"))
+		cache.Get(ctx, "a")             // "a" is now most recently used
+		cache.Set(ctx, "c", thunk("c")) // should evict "b", not "a"
+
+		if _, found := cache.Get(ctx, "b"); found {
+			t.Error("expected \"b\" to have been evicted")
+		}
+		if _, found := cache.Get(ctx, "a"); !found {
+			t.Error("expected \"a\" to still be cached")
+		}
+	})
+
+	t.Run("Clear removes a single entry", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		cache := NewLRUCache(2)
+		cache.Set(ctx, "a", func() (interface{}, error) { return "a", nil })
+		cache.Clear(ctx, "a")
+
+		if _, found := cache.Get(ctx, "a"); found {
+			t.Error("expected entry to be cleared")
+		}
+	})
+
+	t.Run("ClearAll empties the cache", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		cache := NewLRUCache(2)
+		cache.Set(ctx, "a", func() (interface{}, error) { return "a", nil })
+		cache.Set(ctx, "b", func() (interface{}, error) { return "b", nil })
+		cache.ClearAll(ctx)
+
+		if _, found := cache.Get(ctx, "a"); found {
+			t.Error("expected \"a\" to be cleared")
+		}
+		if _, found := cache.Get(ctx, "b"); found {
+			t.Error("expected \"b\" to be cleared")
+		}
+	})
+
+	t.Run("tracks hits and misses", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		cache := NewLRUCache(2)
+		cache.Set(ctx, "a", func() (interface{}, error) { return "a", nil })
+
+		cache.Get(ctx, "a") // hit
+		cache.Get(ctx, "z") // miss
+
+		if got := cache.Hits(); got != 1 {
+			t.Errorf("expected 1 hit, got %d", got)
+		}
+		if got := cache.Misses(); got != 1 {
+			t.Errorf("expected 1 miss, got %d", got)
+		}
+	})
+
+	t.Run("concurrent set and get", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		cache := NewLRUCache(100)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				cache.Set(ctx, i, func() (interface{}, error) { return i, nil })
+				cache.Get(ctx, i)
+			}(i)
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,87 @@
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PrefixCache wraps another Cache, transparently prepending a fixed prefix
+// to every key before delegating to it. This lets a single backing Cache
+// (a bounded LRUCache, say) be shared across many *Loader instances
+// without their keys colliding, much like tendermint's PrefixDB wraps a
+// single backing db.DB for several logical stores.
+type PrefixCache struct {
+	inner  Cache
+	prefix string
+}
+
+var _ Cache = (*PrefixCache)(nil)
+
+// prefixSep separates a PrefixCache's prefix from the underlying key in the
+// backing Cache's key space. Without it, one cache's prefix could be a
+// literal prefix of another's (e.g. "use" of "user:"), causing ownership
+// checks in ClearAll and Keys to match keys they don't actually own.
+const prefixSep = "\x00"
+
+// NewPrefixCache creates a PrefixCache that scopes inner to keys prefixed
+// with prefix.
+func NewPrefixCache(inner Cache, prefix string) *PrefixCache {
+	return &PrefixCache{inner: inner, prefix: prefix}
+}
+
+// prefixed coerces key to a string via fmt.Sprint and prepends the prefix
+// (plus prefixSep), so the prefix applies regardless of the underlying
+// interface{} key type.
+func (c *PrefixCache) prefixed(key interface{}) string {
+	return c.prefix + prefixSep + fmt.Sprint(key)
+}
+
+// owned returns the boundary-safe prefix used to recognize this cache's
+// own keys in the backing Cache.
+func (c *PrefixCache) owned() string {
+	return c.prefix + prefixSep
+}
+
+// Get returns the Thunk cached for key, if any.
+func (c *PrefixCache) Get(ctx context.Context, key interface{}) (Thunk, bool) {
+	return c.inner.Get(ctx, c.prefixed(key))
+}
+
+// Set caches value under key.
+func (c *PrefixCache) Set(ctx context.Context, key interface{}, value Thunk) {
+	c.inner.Set(ctx, c.prefixed(key), value)
+}
+
+// Clear removes key from the cache, if present.
+func (c *PrefixCache) Clear(ctx context.Context, key interface{}) {
+	c.inner.Clear(ctx, c.prefixed(key))
+}
+
+// ClearAll empties only the entries owned by this PrefixCache, leaving
+// other prefixes (and unprefixed keys) in the shared backing Cache intact.
+func (c *PrefixCache) ClearAll(ctx context.Context) {
+	owned := c.owned()
+	for _, key := range c.inner.Keys(ctx) {
+		s, ok := key.(string)
+		if !ok || !strings.HasPrefix(s, owned) {
+			continue
+		}
+		c.inner.Clear(ctx, key)
+	}
+}
+
+// Keys returns the keys this PrefixCache owns in the backing Cache, with
+// the prefix stripped back off.
+func (c *PrefixCache) Keys(ctx context.Context) []interface{} {
+	owned := c.owned()
+	var keys []interface{}
+	for _, key := range c.inner.Keys(ctx) {
+		s, ok := key.(string)
+		if !ok || !strings.HasPrefix(s, owned) {
+			continue
+		}
+		keys = append(keys, s[len(owned):])
+	}
+	return keys
+}
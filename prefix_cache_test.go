@@ -0,0 +1,129 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestPrefixCache(t *testing.T) {
+	ctx := context.Background()
+
+	thunk := func(v interface{}) Thunk {
+		return func() (interface{}, error) { return v, nil }
+	}
+
+	t.Run("scopes keys by prefix so loaders sharing a backing cache don't collide", func(t *testing.T) {
+		t.Parallel()
+		backing := NewInMemoryCache()
+		users := NewPrefixCache(backing, "user:")
+		posts := NewPrefixCache(backing, "post:")
+
+		users.Set(ctx, "1", thunk("alice"))
+		posts.Set(ctx, "1", thunk("hello world"))
+
+		if _, found := users.Get(ctx, "1"); !found {
+			t.Error("expected users cache to see its own entry")
+		}
+		if _, found := posts.Get(ctx, "1"); !found {
+			t.Error("expected posts cache to see its own entry")
+		}
+
+		if got := len(backing.Keys(ctx)); got != 2 {
+			t.Errorf("expected 2 keys in the shared backing cache, got %d", got)
+		}
+	})
+
+	t.Run("Clear only removes the prefixed key", func(t *testing.T) {
+		t.Parallel()
+		backing := NewInMemoryCache()
+		cache := NewPrefixCache(backing, "user:")
+		cache.Set(ctx, 1, thunk("alice"))
+
+		cache.Clear(ctx, 1)
+
+		if _, found := cache.Get(ctx, 1); found {
+			t.Error("expected entry to be cleared")
+		}
+	})
+
+	t.Run("ClearAll only empties keys owned by this prefix", func(t *testing.T) {
+		t.Parallel()
+		backing := NewInMemoryCache()
+		users := NewPrefixCache(backing, "user:")
+		posts := NewPrefixCache(backing, "post:")
+
+		users.Set(ctx, "1", thunk("alice"))
+		users.Set(ctx, "2", thunk("bob"))
+		posts.Set(ctx, "1", thunk("hello world"))
+
+		users.ClearAll(ctx)
+
+		if _, found := users.Get(ctx, "1"); found {
+			t.Error("expected users cache to be empty")
+		}
+		if _, found := posts.Get(ctx, "1"); !found {
+			t.Error("expected posts cache to be untouched by users.ClearAll")
+		}
+	})
+
+	t.Run("a PrefixCache with a prefix of another's does not leak ownership", func(t *testing.T) {
+		t.Parallel()
+		backing := NewInMemoryCache()
+		use := NewPrefixCache(backing, "use")
+		user := NewPrefixCache(backing, "user:")
+
+		use.Set(ctx, "r:1", thunk("should not collide"))
+		user.Set(ctx, "1", thunk("alice"))
+
+		use.ClearAll(ctx)
+
+		if _, found := use.Get(ctx, "r:1"); found {
+			t.Error("expected use cache to be empty after its own ClearAll")
+		}
+		if _, found := user.Get(ctx, "1"); !found {
+			t.Error("expected user cache to be untouched by use.ClearAll, even though \"use\" is a literal prefix of \"user:\"")
+		}
+	})
+
+	t.Run("satisfies Cache so it can be passed to WithCache and shared across loaders", func(t *testing.T) {
+		t.Parallel()
+		backing := NewInMemoryCache()
+
+		var mu sync.Mutex
+		var loadCalls [][]interface{}
+		newLoader := func(prefix string) *Loader {
+			return NewBatchedLoader(func(_ context.Context, keys []interface{}) []*Result {
+				mu.Lock()
+				loadCalls = append(loadCalls, keys)
+				mu.Unlock()
+				results := make([]*Result, len(keys))
+				for i, key := range keys {
+					results[i] = &Result{key, nil}
+				}
+				return results
+			}, WithCache(NewPrefixCache(backing, prefix)))
+		}
+
+		users := newLoader("user:")
+		posts := newLoader("post:")
+
+		future1 := users.Load(ctx, "1")
+		future2 := posts.Load(ctx, "1")
+		future1()
+		future2()
+
+		// A third Load for the same key on each loader should come straight
+		// from the shared backing cache without invoking the batch function
+		// again, proving the two loaders' keys didn't collide.
+		future3 := users.Load(ctx, "1")
+		future3()
+
+		mu.Lock()
+		got := len(loadCalls)
+		mu.Unlock()
+		if got != 2 {
+			t.Errorf("expected exactly 2 batch calls (one per loader), got %d", got)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package dataloader
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("receives the expected event sequence for Prime and Clear", func(t *testing.T) {
+		t.Parallel()
+		loader, _ := IDLoader(0)
+
+		events := make(chan CacheEvent, 10)
+		unsubscribe := loader.Subscribe(events)
+		defer unsubscribe()
+
+		loader.Prime(ctx, "A", "Cached")
+		loader.Clear(ctx, "A")
+		loader.ClearAll()
+
+		want := []CacheOp{CacheOpPrime, CacheOpClear, CacheOpClearAll}
+		for i, op := range want {
+			select {
+			case evt := <-events:
+				if evt.Op != op {
+					t.Errorf("event %d: expected op %v, got %v", i, op, evt.Op)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("event %d: timed out waiting for %v", i, op)
+			}
+		}
+	})
+
+	t.Run("unsubscribe is idempotent and stops delivery", func(t *testing.T) {
+		t.Parallel()
+		loader, _ := IDLoader(0)
+
+		events := make(chan CacheEvent, 10)
+		unsubscribe := loader.Subscribe(events)
+		unsubscribe()
+		unsubscribe()
+
+		loader.Prime(ctx, "A", "Cached")
+
+		select {
+		case evt := <-events:
+			t.Errorf("expected no event after unsubscribe, got %v", evt)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("a slow subscriber does not block a concurrent Load", func(t *testing.T) {
+		t.Parallel()
+		loader, _ := IDLoader(0)
+
+		full := make(chan CacheEvent) // never drained, so every send would block
+		unsubscribe := loader.Subscribe(full)
+		defer unsubscribe()
+
+		loader.Prime(ctx, "A", "Cached")
+
+		done := make(chan struct{})
+		go func() {
+			future := loader.Load(ctx, "1")
+			future()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Load did not complete; a full subscriber channel appears to have blocked it")
+		}
+	})
+}